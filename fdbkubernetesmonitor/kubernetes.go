@@ -22,19 +22,30 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/apple/foundationdb/fdbkubernetesmonitor/api"
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -59,6 +70,68 @@ const (
 
 	// ClusterFileChangeDetectedAnnotation is the annotation that will be updated if the fdb.cluster file is updated.
 	ClusterFileChangeDetectedAnnotation = "foundationdb.org/cluster-file-change"
+
+	// DisableAnnotationWritesAnnotation is a break-glass annotation that operators can set on a
+	// specific Pod to stop updateAnnotationsOnPod from queueing any further annotation changes for it,
+	// e.g. to freeze a Pod's state for debugging. Changes queued before the annotation was observed
+	// are still flushed.
+	DisableAnnotationWritesAnnotation = "foundationdb.org/disable-annotation-writes"
+
+	// leaderElectionLeaseDuration is how long a leader-election lease is valid for before another
+	// instance may acquire it.
+	leaderElectionLeaseDuration = 15 * time.Second
+
+	// leaderElectionRenewDeadline is how long the current leader will retry refreshing its lease
+	// before giving up.
+	leaderElectionRenewDeadline = 10 * time.Second
+
+	// leaderElectionRetryPeriod is how long non-leaders wait between attempts to acquire the lease.
+	leaderElectionRetryPeriod = 2 * time.Second
+
+	// defaultAnnotationWriteDebounceWindow is how long updateAnnotationsOnPod waits for additional
+	// annotation changes to coalesce into the same patch before flushing it to the API server.
+	defaultAnnotationWriteDebounceWindow = 500 * time.Millisecond
+
+	// maxAnnotationWriteRetries caps how many times a failed flush, e.g. one that got a conflict or
+	// throttling response, is retried before the write is given up on.
+	maxAnnotationWriteRetries = 5
+)
+
+var (
+	// annotationWritesEnqueuedTotal counts individual annotation changes requested through
+	// updateAnnotationsOnPod, before coalescing.
+	annotationWritesEnqueuedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fdb_kubernetes_monitor_annotation_writes_enqueued_total",
+		Help: "Number of annotation changes requested for the Pod.",
+	})
+
+	// annotationWritesCoalescedTotal counts annotation changes that were merged into an
+	// already-pending flush instead of triggering a new one.
+	annotationWritesCoalescedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fdb_kubernetes_monitor_annotation_writes_coalesced_total",
+		Help: "Number of annotation changes coalesced into an already-pending write.",
+	})
+
+	// annotationWritesRetriedTotal counts flush attempts that failed and were requeued with
+	// backoff.
+	annotationWritesRetriedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fdb_kubernetes_monitor_annotation_writes_retried_total",
+		Help: "Number of annotation flushes retried after a failure, e.g. a conflict or rate-limit response.",
+	})
+
+	// annotationWritesDroppedTotal counts annotation changes discarded after exhausting
+	// maxAnnotationWriteRetries.
+	annotationWritesDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fdb_kubernetes_monitor_annotation_writes_dropped_total",
+		Help: "Number of annotation changes dropped after exhausting retries.",
+	})
+
+	// annotationEventsDroppedTotal counts AnnotationEvents dropped because a subscriber's channel was
+	// full.
+	annotationEventsDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "fdb_kubernetes_monitor_annotation_events_dropped_total",
+		Help: "Number of annotation change events dropped because a subscriber was not keeping up.",
+	})
 )
 
 // PodClient is a wrapper around the pod API.
@@ -69,18 +142,202 @@ type PodClient struct {
 	// nodeMetadata is the latest metadata that was seen by the fdb-kubernetes-monitor for the according node that hosts the Pod.
 	nodeMetadata *metav1.PartialObjectMetadata
 
-	// TimestampFeed is a channel where the pod client will send updates with
-	// the values from OutdatedConfigMapAnnotation.
-	TimestampFeed chan int64
+	// podMetadataMutex guards podMetadata and nodeMetadata, which the Pod and Node informers can write
+	// from different goroutines.
+	podMetadataMutex sync.Mutex
 
 	// Logger is the logger we use for this client.
 	Logger logr.Logger
 
+	// isLeader tracks whether this fdb-kubernetes-monitor instance currently holds the
+	// leader-election lease for its Pod group. Each instance still watches and writes only its own
+	// Pod, so leadership does not currently gate any code path in this file; it exists for a future
+	// action that must only happen once per Pod group, e.g. a coordinator-file rewrite.
+	isLeader atomic.Bool
+
+	// leaderElector drives the leader-election loop for this Pod group. It is nil when leader
+	// election is disabled, e.g. because the Pod group is not configured with a cluster name and
+	// process class.
+	leaderElector *leaderelection.LeaderElector
+
+	// subscribers maps an annotation key to the channels registered via Subscribe to receive an
+	// AnnotationEvent whenever that key's value changes on the Pod.
+	subscribers map[string][]chan AnnotationEvent
+
+	// subscribersMutex guards subscribers, since Subscribe can be called concurrently with the
+	// informer goroutine delivering OnUpdate events.
+	subscribersMutex sync.Mutex
+
+	// WriteDebounceWindow is how long updateAnnotationsOnPod waits for additional annotation changes
+	// to coalesce into the same patch before flushing it to the API server. Defaults to
+	// defaultAnnotationWriteDebounceWindow.
+	WriteDebounceWindow time.Duration
+
+	// pendingAnnotations accumulates annotation changes that have been requested but not yet flushed
+	// to the API server as a single JSON merge patch.
+	pendingAnnotations map[string]string
+
+	// flushTimer fires WriteDebounceWindow after the first change in a batch, enqueueing a flush of
+	// pendingAnnotations onto writeQueue.
+	flushTimer *time.Timer
+
+	// pendingAnnotationsMutex guards pendingAnnotations and flushTimer.
+	pendingAnnotationsMutex sync.Mutex
+
+	// writeQueue rate-limits and retries, with exponential backoff, flushes that fail with any error,
+	// e.g. a conflict or throttling response from the API server.
+	writeQueue workqueue.RateLimitingInterface
+
 	// Adds the controller runtime client to the PodClient.
 	client.Client
 }
 
-func setupCache(namespace string, podName string, nodeName string) (client.WithWatch, cache.Cache, error) {
+// AnnotationEvent describes a change to a single annotation on the Pod, as observed by OnUpdate.
+type AnnotationEvent struct {
+	// Key is the annotation key that changed.
+	Key string
+
+	// OldValue is the previous value of the annotation, or "" if it was not previously set.
+	OldValue string
+
+	// NewValue is the current value of the annotation, or "" if it was removed.
+	NewValue string
+
+	// ResourceVersion is the resourceVersion of the Pod at the time this change was observed.
+	ResourceVersion string
+
+	// ObservedAt is the time the fdb-kubernetes-monitor observed this change.
+	ObservedAt time.Time
+}
+
+// Subscribe registers a channel that receives an AnnotationEvent every time annotationKey's value
+// changes on the Pod, including when it is added or removed. The returned channel is buffered and is
+// never closed.
+func (podClient *PodClient) Subscribe(annotationKey string) <-chan AnnotationEvent {
+	subscriberChan := make(chan AnnotationEvent, 10)
+
+	podClient.subscribersMutex.Lock()
+	defer podClient.subscribersMutex.Unlock()
+
+	if podClient.subscribers == nil {
+		podClient.subscribers = make(map[string][]chan AnnotationEvent)
+	}
+	podClient.subscribers[annotationKey] = append(podClient.subscribers[annotationKey], subscriberChan)
+
+	return subscriberChan
+}
+
+// SubscribeMulti registers a channel that receives an AnnotationEvent every time any of keys changes on
+// the Pod. It is equivalent to calling Subscribe for each key and merging the results into one channel.
+func (podClient *PodClient) SubscribeMulti(keys ...string) <-chan AnnotationEvent {
+	merged := make(chan AnnotationEvent, 10*len(keys))
+
+	for _, key := range keys {
+		subscriberChan := podClient.Subscribe(key)
+		go func() {
+			for event := range subscriberChan {
+				merged <- event
+			}
+		}()
+	}
+
+	return merged
+}
+
+// publishAnnotationChanges diffs previous against current and notifies any subscribers registered for
+// a key whose value changed between the two, including keys that were added or removed. Subscriber
+// channels are only buffered to a fixed depth and are never closed, so delivery is best-effort: a
+// subscriber that isn't keeping up has its event dropped rather than blocking this call, which runs on
+// the single informer delivery path shared by every OnUpdate.
+func (podClient *PodClient) publishAnnotationChanges(previous map[string]string, current map[string]string, resourceVersion string) {
+	if len(previous) == 0 && len(current) == 0 {
+		return
+	}
+
+	podClient.subscribersMutex.Lock()
+	if len(podClient.subscribers) == 0 {
+		podClient.subscribersMutex.Unlock()
+		return
+	}
+
+	observedAt := time.Now()
+	changedKeys := make(map[string]struct{}, len(previous)+len(current))
+	for key := range previous {
+		changedKeys[key] = struct{}{}
+	}
+	for key := range current {
+		changedKeys[key] = struct{}{}
+	}
+
+	subscribersByKey := make(map[string][]chan AnnotationEvent, len(changedKeys))
+	for key := range changedKeys {
+		if oldValue, newValue := previous[key], current[key]; oldValue != newValue {
+			subscribersByKey[key] = podClient.subscribers[key]
+		}
+	}
+	podClient.subscribersMutex.Unlock()
+
+	for key, subscriberChans := range subscribersByKey {
+		event := AnnotationEvent{
+			Key:             key,
+			OldValue:        previous[key],
+			NewValue:        current[key],
+			ResourceVersion: resourceVersion,
+			ObservedAt:      observedAt,
+		}
+
+		for _, subscriberChan := range subscriberChans {
+			select {
+			case subscriberChan <- event:
+			default:
+				annotationEventsDroppedTotal.Inc()
+				podClient.Logger.Info("Dropping annotation event, subscriber is not keeping up", "key", key)
+			}
+		}
+	}
+}
+
+// IsLeader returns true if this fdb-kubernetes-monitor instance currently holds the leader-election
+// lease for its Pod group, or if leader election is disabled.
+func (podClient *PodClient) IsLeader() bool {
+	if podClient.leaderElector == nil {
+		return true
+	}
+
+	return podClient.isLeader.Load()
+}
+
+// ClusterProvider builds the watch client and cache for a single Kubernetes cluster. The local cluster
+// hosts the Pod/Node this process belongs to and is the only one CreatePodClient registers the Pod/Node
+// informers on. A control-plane cluster is an additional, independent cluster - e.g. one hosting a
+// federated FoundationDB resource that spans several local clusters - whose cache CreatePodClient starts
+// but does not yet attach any informer to; it is a connection point for a future federated-resource
+// reconciler, not a second source of this Pod's own identity.
+type ClusterProvider interface {
+	// Name identifies the cluster, used for logging.
+	Name() string
+
+	// Connect builds the watch client and cache for this cluster. The cache is not started; the
+	// caller is responsible for registering informers and starting it.
+	Connect() (client.WithWatch, cache.Cache, error)
+}
+
+// localClusterProvider connects to the Kubernetes cluster the fdb-kubernetes-monitor Pod itself is
+// running in, using the in-cluster config. Its cache is restricted to the Pod and Node this process
+// cares about.
+type localClusterProvider struct {
+	namespace string
+	podName   string
+	nodeName  string
+}
+
+// Name implements ClusterProvider.
+func (p *localClusterProvider) Name() string {
+	return "local"
+}
+
+// Connect implements ClusterProvider.
+func (p *localClusterProvider) Connect() (client.WithWatch, cache.Cache, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, nil, err
@@ -103,13 +360,13 @@ func setupCache(namespace string, podName string, nodeName string) (client.WithW
 	internalCache, err := cache.New(config, cache.Options{
 		Scheme:    scheme,
 		Mapper:    internalClient.RESTMapper(),
-		Namespace: namespace,
+		Namespace: p.namespace,
 		SelectorsByObject: map[client.Object]cache.ObjectSelector{
 			&corev1.Pod{}: {
-				Field: fields.OneTermEqualSelector(metav1.ObjectNameField, podName),
+				Field: fields.OneTermEqualSelector(metav1.ObjectNameField, p.podName),
 			},
 			&corev1.Node{}: {
-				Field: fields.OneTermEqualSelector(metav1.ObjectNameField, nodeName),
+				Field: fields.OneTermEqualSelector(metav1.ObjectNameField, p.nodeName),
 			},
 		},
 	})
@@ -120,22 +377,175 @@ func setupCache(namespace string, podName string, nodeName string) (client.WithW
 	return internalClient, internalCache, nil
 }
 
-// CreatePodClient creates a new client for working with the pod object.
-func CreatePodClient(ctx context.Context, logger logr.Logger, enableNodeWatcher bool, setupCache func(string, string, string) (client.WithWatch, cache.Cache, error)) (*PodClient, error) {
+// controlPlaneClusterProvider connects to a control-plane Kubernetes cluster that is not this process's
+// own cluster - e.g. one hosting a federated FoundationDB resource shared by several local clusters -
+// using a kubeconfig secret mounted into the Pod rather than the in-cluster config used for the local
+// cluster. A Pod/Node cannot exist in two clusters, so unlike localClusterProvider this cache is not
+// scoped to this process's own Pod/Node; CreatePodClient does not register Pod/Node informers on it.
+type controlPlaneClusterProvider struct {
+	name           string
+	kubeconfigPath string
+	namespace      string
+}
+
+// NewControlPlaneClusterProvider creates a ClusterProvider for a control-plane cluster, identified by
+// name for logging, whose client config is read from the kubeconfig at kubeconfigPath (typically a
+// mounted Secret) and whose cache is restricted to namespace.
+func NewControlPlaneClusterProvider(name string, kubeconfigPath string, namespace string) ClusterProvider {
+	return &controlPlaneClusterProvider{
+		name:           name,
+		kubeconfigPath: kubeconfigPath,
+		namespace:      namespace,
+	}
+}
+
+// Name implements ClusterProvider.
+func (p *controlPlaneClusterProvider) Name() string {
+	return p.name
+}
+
+// Connect implements ClusterProvider.
+func (p *controlPlaneClusterProvider) Connect() (client.WithWatch, cache.Cache, error) {
+	config, err := clientcmd.BuildConfigFromFlags("", p.kubeconfigPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := runtime.NewScheme()
+	err = clientgoscheme.AddToScheme(scheme)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	internalClient, err := client.NewWithWatch(config, client.Options{
+		Scheme: scheme,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	internalCache, err := cache.New(config, cache.Options{
+		Scheme:    scheme,
+		Mapper:    internalClient.RESTMapper(),
+		Namespace: p.namespace,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return internalClient, internalCache, nil
+}
+
+// setupCache connects to the local cluster, which is always first in providers, plus any additional
+// control-plane clusters, and returns the local client (used for all Pod/Node reads and annotation
+// writes) alongside every provider's cache in the same order. The caches are returned unstarted;
+// CreatePodClient starts every one of them but only registers the Pod/Node informers on the local
+// cluster's cache.
+func setupCache(providers []ClusterProvider) (client.WithWatch, []cache.Cache, error) {
+	var localClient client.WithWatch
+	caches := make([]cache.Cache, 0, len(providers))
+
+	for i, provider := range providers {
+		providerClient, providerCache, err := provider.Connect()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if i == 0 {
+			localClient = providerClient
+		}
+
+		caches = append(caches, providerCache)
+	}
+
+	return localClient, caches, nil
+}
+
+// startLeaderElection begins a leader-election loop for the Pod group identified by clusterName and
+// processClass, using a coordination.k8s.io Lease as the lock, so IsLeader reports at most one
+// fdb-kubernetes-monitor instance in the group as leader at a time.
+func (podClient *PodClient) startLeaderElection(ctx context.Context, namespace string, podName string, clusterName string, processClass string) error {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("fdb-kubernetes-monitor-%s-%s", clusterName, processClass),
+			Namespace: namespace,
+		},
+		Client: clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: podName,
+		},
+	}
+
+	leaderElector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   leaderElectionLeaseDuration,
+		RenewDeadline:   leaderElectionRenewDeadline,
+		RetryPeriod:     leaderElectionRetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(_ context.Context) {
+				podClient.Logger.Info("Became leader for Pod group", "cluster", clusterName, "processClass", processClass)
+				podClient.isLeader.Store(true)
+			},
+			OnStoppedLeading: func() {
+				podClient.Logger.Info("Lost leadership for Pod group", "cluster", clusterName, "processClass", processClass)
+				podClient.isLeader.Store(false)
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	podClient.leaderElector = leaderElector
+	go leaderElector.Run(ctx)
+
+	return nil
+}
+
+// CreatePodClient creates a new client for working with the pod object. controlPlaneProviders lists any
+// additional control-plane clusters beyond the local cluster; their caches are connected, started and
+// synced, but no informers or event handlers are registered on them.
+func CreatePodClient(ctx context.Context, logger logr.Logger, enableNodeWatcher bool, setupCache func([]ClusterProvider) (client.WithWatch, []cache.Cache, error), controlPlaneProviders []ClusterProvider) (*PodClient, error) {
 	namespace := os.Getenv("FDB_POD_NAMESPACE")
 	podName := os.Getenv("FDB_POD_NAME")
 	nodeName := os.Getenv("FDB_NODE_NAME")
 
-	internalClient, internalCache, err := setupCache(namespace, podName, nodeName)
+	providers := append([]ClusterProvider{&localClusterProvider{
+		namespace: namespace,
+		podName:   podName,
+		nodeName:  nodeName,
+	}}, controlPlaneProviders...)
+
+	internalClient, caches, err := setupCache(providers)
+	if err != nil {
+		return nil, err
+	}
+
+	internalCache := caches[0]
 	podClient := &PodClient{
-		podMetadata:   nil,
-		nodeMetadata:  nil,
-		TimestampFeed: make(chan int64, 10),
-		Logger:        logger,
+		podMetadata:  nil,
+		nodeMetadata: nil,
+		Logger:       logger,
 	}
 
-	// Fetch the informer for the Pod resource.
-	podInformer, err := internalCache.GetInformer(ctx, &corev1.Pod{})
+	// Fetch the informer for the Pod resource. Passing a PartialObjectMetadata with the Pod GVK set
+	// makes controller-runtime set up a metadata-only informer: the cache only ever stores
+	// *metav1.PartialObjectMetadata, not the full PodSpec/Status, which matters on large clusters
+	// where thousands of fdbserver Pods each run a monitor. Only the local cluster's cache is scoped
+	// to this process's own Pod/Node, so only it gets these handlers; any control-plane cluster's
+	// cache is started below but otherwise untouched by this file.
+	podInformer, err := internalCache.GetInformer(ctx, newPodPartialMetadata())
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +558,8 @@ func CreatePodClient(ctx context.Context, logger logr.Logger, enableNodeWatcher
 
 	if enableNodeWatcher {
 		var nodeInformer cache.Informer
-		// Fetch the informer for the node resource.
-		nodeInformer, err = internalCache.GetInformer(ctx, &corev1.Node{})
+		// Fetch the metadata-only informer for the node resource.
+		nodeInformer, err = internalCache.GetInformer(ctx, newNodePartialMetadata())
 		if err != nil {
 			return nil, err
 		}
@@ -161,13 +571,20 @@ func CreatePodClient(ctx context.Context, logger logr.Logger, enableNodeWatcher
 		}
 	}
 
-	// Make sure the internal cache is started.
-	go func() {
-		_ = internalCache.Start(ctx)
-	}()
+	// Make sure every cluster's cache is started, local cluster first followed by any control-plane
+	// clusters.
+	for _, providerCache := range caches {
+		providerCache := providerCache
+		go func() {
+			_ = providerCache.Start(ctx)
+		}()
+	}
 
 	// This should be fairly quick as no informers are provided by default.
-	internalCache.WaitForCacheSync(ctx)
+	for _, providerCache := range caches {
+		providerCache.WaitForCacheSync(ctx)
+	}
+
 	controllerClient, err := client.NewDelegatingClient(client.NewDelegatingClientInput{
 		CacheReader:       internalCache,
 		Client:            internalClient,
@@ -182,28 +599,47 @@ func CreatePodClient(ctx context.Context, logger logr.Logger, enableNodeWatcher
 	podClient.Client = controllerClient
 
 	// Fetch the current metadata before returning the PodClient
-	currentPodMetadata := &metav1.PartialObjectMetadata{}
-	currentPodMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	currentPodMetadata := newPodPartialMetadata()
 	err = podClient.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: podName}, currentPodMetadata)
 	if err != nil {
 		return nil, err
 	}
 
+	podClient.podMetadataMutex.Lock()
 	podClient.podMetadata = currentPodMetadata
+	podClient.podMetadataMutex.Unlock()
 
 	// Only if the fdb-kubernetes-monitor should update the node information, add the watcher here by fetching the node
 	// information once during start up.
 	if enableNodeWatcher {
-		currentNodeMetadata := &metav1.PartialObjectMetadata{}
-		currentNodeMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+		currentNodeMetadata := newNodePartialMetadata()
 		err = podClient.Client.Get(ctx, client.ObjectKey{Name: nodeName}, currentNodeMetadata)
 		if err != nil {
 			return nil, err
 		}
 
+		podClient.podMetadataMutex.Lock()
 		podClient.nodeMetadata = currentNodeMetadata
+		podClient.podMetadataMutex.Unlock()
 	}
 
+	// If this Pod is part of a Pod group, elect a single leader for it. Pods without both
+	// FDB_CLUSTER_NAME and FDB_PROCESS_CLASS set run standalone and always act as their own leader.
+	clusterName := os.Getenv("FDB_CLUSTER_NAME")
+	processClass := os.Getenv("FDB_PROCESS_CLASS")
+	if clusterName != "" && processClass != "" {
+		err = podClient.startLeaderElection(ctx, namespace, podName, clusterName, processClass)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Start the queue that coalesces and rate-limits annotation writes instead of issuing a PATCH
+	// for every call to updateAnnotationsOnPod.
+	podClient.WriteDebounceWindow = defaultAnnotationWriteDebounceWindow
+	podClient.writeQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	go podClient.runAnnotationWriteQueue(ctx)
+
 	return podClient, nil
 }
 
@@ -250,46 +686,196 @@ func (podClient *PodClient) updateFdbClusterTimestampAnnotation() error {
 	})
 }
 
-// updateAnnotationsOnPod will update the annotations with the provided annotationChanges. If an annotation exists, it
-// will be updated if the annotation is absent it will be added.
+// updateAnnotationsOnPod will update the annotations with the provided annotationChanges. If an
+// annotation exists, it will be updated if the annotation is absent it will be added. The write is not
+// applied immediately: it is merged into any other changes requested within WriteDebounceWindow and
+// flushed as a single JSON merge patch, so that a burst of calls - e.g. a config reload racing a
+// coordinators change - produces one PATCH instead of one per call.
 func (podClient *PodClient) updateAnnotationsOnPod(annotationChanges map[string]string) error {
-	annotations := podClient.podMetadata.Annotations
-	if len(annotations) == 0 {
-		annotations = map[string]string{}
+	podClient.podMetadataMutex.Lock()
+	_, disabled := podClient.podMetadata.Annotations[DisableAnnotationWritesAnnotation]
+	podClient.podMetadataMutex.Unlock()
+	if disabled {
+		podClient.Logger.Info("Skipping annotation update, annotation writes are disabled for this Pod via the break-glass annotation")
+		return nil
+	}
+
+	podClient.enqueueAnnotationWrite(annotationChanges)
+
+	return nil
+}
+
+// enqueueAnnotationWrite merges annotationChanges into the pending batch and, if this is the first
+// change in a new batch, schedules a flush after WriteDebounceWindow.
+func (podClient *PodClient) enqueueAnnotationWrite(annotationChanges map[string]string) {
+	podClient.pendingAnnotationsMutex.Lock()
+	defer podClient.pendingAnnotationsMutex.Unlock()
+
+	annotationWritesEnqueuedTotal.Add(float64(len(annotationChanges)))
+
+	if len(podClient.pendingAnnotations) > 0 {
+		annotationWritesCoalescedTotal.Add(float64(len(annotationChanges)))
+	}
+
+	if podClient.pendingAnnotations == nil {
+		podClient.pendingAnnotations = make(map[string]string, len(annotationChanges))
 	}
 
 	for key, val := range annotationChanges {
-		annotations[key] = val
+		podClient.pendingAnnotations[key] = val
+	}
+
+	if podClient.flushTimer != nil {
+		return
+	}
+
+	debounceWindow := podClient.WriteDebounceWindow
+	if debounceWindow == 0 {
+		debounceWindow = defaultAnnotationWriteDebounceWindow
 	}
 
-	return podClient.Patch(context.Background(), &corev1.Pod{
+	podClient.flushTimer = time.AfterFunc(debounceWindow, func() {
+		podClient.writeQueue.Add(struct{}{})
+	})
+}
+
+// runAnnotationWriteQueue processes flush requests from writeQueue until ctx is done.
+func (podClient *PodClient) runAnnotationWriteQueue(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		podClient.writeQueue.ShutDown()
+	}()
+
+	for podClient.processNextWriteQueueItem() {
+	}
+}
+
+// processNextWriteQueueItem flushes the pending annotations once, requeueing with exponential backoff
+// on failure. A failed batch is restored to pendingAnnotations so the retry still includes it; once
+// retries are exhausted the batch is discarded for good. It returns false once writeQueue has been
+// shut down.
+func (podClient *PodClient) processNextWriteQueueItem() bool {
+	item, shutdown := podClient.writeQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer podClient.writeQueue.Done(item)
+
+	annotationChanges, err := podClient.flushPendingAnnotations()
+	if err == nil {
+		podClient.writeQueue.Forget(item)
+		return true
+	}
+
+	if podClient.writeQueue.NumRequeues(item) >= maxAnnotationWriteRetries {
+		annotationWritesDroppedTotal.Add(float64(len(annotationChanges)))
+		podClient.Logger.Error(err, "Dropping annotation write after exhausting retries", "keys", len(annotationChanges))
+		podClient.writeQueue.Forget(item)
+		return true
+	}
+
+	annotationWritesRetriedTotal.Inc()
+	podClient.restorePendingAnnotations(annotationChanges)
+	podClient.writeQueue.AddRateLimited(item)
+	return true
+}
+
+// flushPendingAnnotations takes the current pending batch and applies it to the Pod as a single JSON
+// merge patch containing only the changed keys. It returns the batch it attempted to apply - even on
+// failure - so the caller can decide whether to restore it for a retry or let it be discarded.
+func (podClient *PodClient) flushPendingAnnotations() (map[string]string, error) {
+	podClient.pendingAnnotationsMutex.Lock()
+	annotationChanges := podClient.pendingAnnotations
+	podClient.pendingAnnotations = nil
+	podClient.flushTimer = nil
+	podClient.pendingAnnotationsMutex.Unlock()
+
+	if len(annotationChanges) == 0 {
+		return nil, nil
+	}
+
+	mergePatch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotationChanges,
+		},
+	})
+	if err != nil {
+		return annotationChanges, err
+	}
+
+	podClient.podMetadataMutex.Lock()
+	podNamespace, podName := podClient.podMetadata.Namespace, podClient.podMetadata.Name
+	podClient.podMetadataMutex.Unlock()
+
+	err = podClient.Patch(context.Background(), &corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Pod",
 			APIVersion: "v1",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Namespace:   podClient.podMetadata.Namespace,
-			Name:        podClient.podMetadata.Name,
-			Annotations: annotations,
+			Namespace: podNamespace,
+			Name:      podName,
 		},
-	}, client.Apply, client.FieldOwner("fdb-kubernetes-monitor"), client.ForceOwnership)
+	}, client.RawPatch(types.MergePatchType, mergePatch))
+	if err != nil {
+		return annotationChanges, err
+	}
+
+	return annotationChanges, nil
+}
+
+// restorePendingAnnotations merges annotationChanges back into the pending batch after a failed flush.
+// A key already overwritten by a newer change made while the flush was in flight keeps that newer
+// value rather than being reset to the one that just failed to apply.
+func (podClient *PodClient) restorePendingAnnotations(annotationChanges map[string]string) {
+	podClient.pendingAnnotationsMutex.Lock()
+	defer podClient.pendingAnnotationsMutex.Unlock()
+
+	if podClient.pendingAnnotations == nil {
+		podClient.pendingAnnotations = make(map[string]string, len(annotationChanges))
+	}
+
+	for key, val := range annotationChanges {
+		if _, present := podClient.pendingAnnotations[key]; !present {
+			podClient.pendingAnnotations[key] = val
+		}
+	}
+}
+
+// newPodPartialMetadata returns an empty PartialObjectMetadata typed for the Pod GVK, used to request a
+// metadata-only informer and Get instead of caching the full Pod object.
+func newPodPartialMetadata() *metav1.PartialObjectMetadata {
+	partialMetadata := &metav1.PartialObjectMetadata{}
+	partialMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	return partialMetadata
+}
+
+// newNodePartialMetadata returns an empty PartialObjectMetadata typed for the Node GVK, used to request
+// a metadata-only informer and Get instead of caching the full Node object.
+func newNodePartialMetadata() *metav1.PartialObjectMetadata {
+	partialMetadata := &metav1.PartialObjectMetadata{}
+	partialMetadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Node"))
+	return partialMetadata
 }
 
 // OnAdd is called when an object is added.
 func (podClient *PodClient) OnAdd(obj interface{}) {
-	switch castedObj := obj.(type) {
-	case *corev1.Pod:
-		podClient.Logger.Info("Got event for OnAdd for Pod resource", "name", castedObj.Name, "namespace", castedObj.Namespace)
-		podClient.podMetadata = &metav1.PartialObjectMetadata{
-			TypeMeta:   castedObj.TypeMeta,
-			ObjectMeta: castedObj.ObjectMeta,
-		}
-	case *corev1.Node:
-		podClient.Logger.Info("Got event for OnAdd for Node resource", "name", castedObj.Name)
-		podClient.nodeMetadata = &metav1.PartialObjectMetadata{
-			TypeMeta:   castedObj.TypeMeta,
-			ObjectMeta: castedObj.ObjectMeta,
-		}
+	partialMetadata, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return
+	}
+
+	switch partialMetadata.Kind {
+	case "Pod":
+		podClient.Logger.Info("Got event for OnAdd for Pod resource", "name", partialMetadata.Name, "namespace", partialMetadata.Namespace)
+		podClient.podMetadataMutex.Lock()
+		podClient.podMetadata = partialMetadata
+		podClient.podMetadataMutex.Unlock()
+	case "Node":
+		podClient.Logger.Info("Got event for OnAdd for Node resource", "name", partialMetadata.Name)
+		podClient.podMetadataMutex.Lock()
+		podClient.nodeMetadata = partialMetadata
+		podClient.podMetadataMutex.Unlock()
 	}
 }
 
@@ -297,36 +883,29 @@ func (podClient *PodClient) OnAdd(obj interface{}) {
 // get called even if nothing changed. This is useful for periodically
 // evaluating or syncing something.
 func (podClient *PodClient) OnUpdate(_, newObj interface{}) {
-	switch castedObj := newObj.(type) {
-	case *corev1.Pod:
-		podClient.Logger.Info("Got event for OnUpdate for Pod resource", "name", castedObj.Name, "namespace", castedObj.Namespace, "generation", castedObj.Generation)
-		podClient.podMetadata = &metav1.PartialObjectMetadata{
-			TypeMeta:   castedObj.TypeMeta,
-			ObjectMeta: castedObj.ObjectMeta,
-		}
-
-		if podClient.podMetadata.Annotations == nil {
-			return
-		}
-
-		annotation := podClient.podMetadata.Annotations[OutdatedConfigMapAnnotation]
-		if annotation == "" {
-			return
-		}
+	partialMetadata, ok := newObj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return
+	}
 
-		timestamp, err := strconv.ParseInt(annotation, 10, 64)
-		if err != nil {
-			podClient.Logger.Error(err, "Error parsing annotation", "key", OutdatedConfigMapAnnotation, "rawAnnotation", annotation)
-			return
-		}
+	switch partialMetadata.Kind {
+	case "Pod":
+		podClient.Logger.Info("Got event for OnUpdate for Pod resource", "name", partialMetadata.Name, "namespace", partialMetadata.Namespace, "generation", partialMetadata.Generation)
 
-		podClient.TimestampFeed <- timestamp
-	case *corev1.Node:
-		podClient.Logger.Info("Got event for OnUpdate for Node resource", "name", castedObj.Name)
-		podClient.nodeMetadata = &metav1.PartialObjectMetadata{
-			TypeMeta:   castedObj.TypeMeta,
-			ObjectMeta: castedObj.ObjectMeta,
+		podClient.podMetadataMutex.Lock()
+		var previousAnnotations map[string]string
+		if podClient.podMetadata != nil {
+			previousAnnotations = podClient.podMetadata.Annotations
 		}
+		podClient.podMetadata = partialMetadata
+		podClient.podMetadataMutex.Unlock()
+
+		podClient.publishAnnotationChanges(previousAnnotations, partialMetadata.Annotations, partialMetadata.ResourceVersion)
+	case "Node":
+		podClient.Logger.Info("Got event for OnUpdate for Node resource", "name", partialMetadata.Name)
+		podClient.podMetadataMutex.Lock()
+		podClient.nodeMetadata = partialMetadata
+		podClient.podMetadataMutex.Unlock()
 	}
 }
 
@@ -335,12 +914,21 @@ func (podClient *PodClient) OnUpdate(_, newObj interface{}) {
 // happen if the watch is closed and misses the delete event and we don't
 // notice the deletion until the subsequent re-list.
 func (podClient *PodClient) OnDelete(obj interface{}) {
-	switch castedObj := obj.(type) {
-	case *corev1.Pod:
-		podClient.Logger.Info("Got event for OnDelete for Pod resource", "name", castedObj.Name, "namespace", castedObj.Namespace)
+	partialMetadata, ok := obj.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return
+	}
+
+	switch partialMetadata.Kind {
+	case "Pod":
+		podClient.Logger.Info("Got event for OnDelete for Pod resource", "name", partialMetadata.Name, "namespace", partialMetadata.Namespace)
+		podClient.podMetadataMutex.Lock()
 		podClient.podMetadata = nil
-	case *corev1.Node:
-		podClient.Logger.Info("Got event for OnDelete for Node resource", "name", castedObj.Name)
+		podClient.podMetadataMutex.Unlock()
+	case "Node":
+		podClient.Logger.Info("Got event for OnDelete for Node resource", "name", partialMetadata.Name)
+		podClient.podMetadataMutex.Lock()
 		podClient.nodeMetadata = nil
+		podClient.podMetadataMutex.Unlock()
 	}
 }